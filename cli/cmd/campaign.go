@@ -0,0 +1,353 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/osrapps/osr-console/cli/internal/campaignstore"
+	"github.com/osrapps/osr-console/cli/internal/characterstore"
+)
+
+var campaign = &cobra.Command{
+	Use:   "campaign {create|delete|list|show|add-party-member|remove-party-member}",
+	Short: "Manage campaigns.",
+	Long:  `Create, delete, list, and show campaigns, and manage their party rosters.`,
+}
+
+var campaignCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a new campaign.",
+	RunE:  runCampaignCreate,
+}
+
+var campaignDeleteCmd = &cobra.Command{
+	Use:               "delete <name>",
+	Short:             "Delete a campaign.",
+	Args:              cobra.ExactArgs(1),
+	RunE:              runCampaignDelete,
+	ValidArgsFunction: completeCampaignNames,
+}
+
+var campaignListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List campaigns.",
+	RunE:  runCampaignList,
+}
+
+var campaignShowCmd = &cobra.Command{
+	Use:               "show <name>",
+	Short:             "Show a campaign's party, location, XP, and session notes.",
+	Args:              cobra.ExactArgs(1),
+	RunE:              runCampaignShow,
+	ValidArgsFunction: completeCampaignNames,
+}
+
+var campaignAddPartyMemberCmd = &cobra.Command{
+	Use:               "add-party-member <character>",
+	Short:             "Add a character to a campaign's party.",
+	Args:              cobra.ExactArgs(1),
+	RunE:              runCampaignAddPartyMember,
+	ValidArgsFunction: completeCharacterNames,
+}
+
+var campaignRemovePartyMemberCmd = &cobra.Command{
+	Use:               "remove-party-member <character>",
+	Short:             "Remove a character from a campaign's party.",
+	Args:              cobra.ExactArgs(1),
+	RunE:              runCampaignRemovePartyMember,
+	ValidArgsFunction: completePartyMemberNames,
+}
+
+var (
+	campaignCreateName     string
+	campaignCreateLocation string
+	campaignCreateForce    bool
+	campaignSelector       string
+)
+
+func init() {
+	rootCmd.AddCommand(campaign)
+	campaign.AddCommand(
+		campaignCreateCmd,
+		campaignDeleteCmd,
+		campaignListCmd,
+		campaignShowCmd,
+		campaignAddPartyMemberCmd,
+		campaignRemovePartyMemberCmd,
+	)
+
+	campaignCreateCmd.Flags().StringVar(&campaignCreateName, "name", "", "campaign name (required)")
+	campaignCreateCmd.Flags().StringVar(&campaignCreateLocation, "location", "", "starting adventuring location")
+	campaignCreateCmd.Flags().BoolVar(&campaignCreateForce, "force", false, "overwrite an existing campaign with the same name")
+	campaignCreateCmd.MarkFlagRequired("name")
+
+	campaignAddPartyMemberCmd.Flags().StringVar(&campaignSelector, "campaign", "", "campaign to add the character to (required)")
+	campaignAddPartyMemberCmd.MarkFlagRequired("campaign")
+	campaignAddPartyMemberCmd.RegisterFlagCompletionFunc("campaign", completeCampaignNames)
+
+	campaignRemovePartyMemberCmd.Flags().StringVar(&campaignSelector, "campaign", "", "campaign to remove the character from (required)")
+	campaignRemovePartyMemberCmd.MarkFlagRequired("campaign")
+	campaignRemovePartyMemberCmd.RegisterFlagCompletionFunc("campaign", completeCampaignNames)
+
+	characterShowCmd.Flags().StringVar(&characterShowCampaign, "campaign", "", "restrict the lookup to this campaign's party")
+	characterShowCmd.RegisterFlagCompletionFunc("campaign", completeCampaignNames)
+}
+
+// completeCampaignNames completes a campaign name argument or flag value
+// from the names of saved campaigns.
+func completeCampaignNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	store, err := openCampaignStore()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	campaigns, err := store.List()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	names := make([]string, 0, len(campaigns))
+	for _, c := range campaigns {
+		names = append(names, c.Name)
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completePartyMemberNames completes the <character> argument of
+// remove-party-member from the members of the campaign named by --campaign,
+// since those are the only characters removal can succeed for.
+func completePartyMemberNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if campaignSelector == "" {
+		return completeCharacterNames(cmd, args, toComplete)
+	}
+	store, err := openCampaignStore()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	c, err := store.Load(campaignSelector)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	return c.Party, cobra.ShellCompDirectiveNoFileComp
+}
+
+func runCampaignCreate(cmd *cobra.Command, args []string) error {
+	store, err := openCampaignStore()
+	if err != nil {
+		return err
+	}
+	if !campaignCreateForce {
+		exists, err := store.Exists(campaignCreateName)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("campaign %q already exists (use --force to overwrite)", campaignCreateName)
+		}
+	}
+
+	c := &campaignstore.Campaign{
+		ID:        campaignCreateName,
+		Name:      campaignCreateName,
+		Location:  campaignCreateLocation,
+		CreatedAt: time.Now(),
+	}
+	if err := store.Save(c); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Created campaign %q.\n", c.Name)
+	return nil
+}
+
+func runCampaignDelete(cmd *cobra.Command, args []string) error {
+	store, err := openCampaignStore()
+	if err != nil {
+		return err
+	}
+
+	c, err := store.Load(args[0])
+	if err != nil {
+		return err
+	}
+	if err := unlinkParty(c); err != nil {
+		return err
+	}
+
+	if err := store.Delete(args[0]); err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Deleted campaign %q.\n", args[0])
+	return nil
+}
+
+// unlinkParty clears the Campaign back-reference on every character in c's
+// party, so a deleted campaign doesn't leave characters pointing at it.
+func unlinkParty(c *campaignstore.Campaign) error {
+	charStore, err := openCharacterStore()
+	if err != nil {
+		return err
+	}
+	for _, member := range c.Party {
+		rec, err := charStore.Load(member)
+		if err != nil {
+			continue
+		}
+		if rec.Campaign != c.Name {
+			continue
+		}
+		rec.Campaign = ""
+		if err := charStore.Save(rec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func runCampaignList(cmd *cobra.Command, args []string) error {
+	store, err := openCampaignStore()
+	if err != nil {
+		return err
+	}
+
+	campaigns, err := store.List()
+	if err != nil {
+		return err
+	}
+	if len(campaigns) == 0 {
+		fmt.Fprintln(cmd.OutOrStdout(), "No campaigns found.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tLOCATION\tXP\tPARTY SIZE")
+	for _, c := range campaigns {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\n", c.Name, c.Location, c.XP, len(c.Party))
+	}
+	return w.Flush()
+}
+
+func runCampaignShow(cmd *cobra.Command, args []string) error {
+	store, err := openCampaignStore()
+	if err != nil {
+		return err
+	}
+
+	c, err := store.Load(args[0])
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "%s\n", c.Name)
+	fmt.Fprintf(cmd.OutOrStdout(), "Location: %s\n", c.Location)
+	fmt.Fprintf(cmd.OutOrStdout(), "XP: %d\n", c.XP)
+	fmt.Fprintln(cmd.OutOrStdout(), "Party:")
+	if len(c.Party) == 0 {
+		fmt.Fprintln(cmd.OutOrStdout(), "  (none)")
+	}
+	for _, member := range c.Party {
+		fmt.Fprintf(cmd.OutOrStdout(), "  - %s\n", member)
+	}
+	if len(c.SessionNotes) > 0 {
+		fmt.Fprintln(cmd.OutOrStdout(), "Session notes:")
+		for _, note := range c.SessionNotes {
+			fmt.Fprintf(cmd.OutOrStdout(), "  - %s\n", note)
+		}
+	}
+	return nil
+}
+
+func runCampaignAddPartyMember(cmd *cobra.Command, args []string) error {
+	characterName := args[0]
+
+	charStore, err := openCharacterStore()
+	if err != nil {
+		return err
+	}
+	rec, err := charStore.Load(characterName)
+	if err != nil {
+		return fmt.Errorf("add-party-member: %w", err)
+	}
+
+	campaignStore, err := openCampaignStore()
+	if err != nil {
+		return err
+	}
+	c, err := campaignStore.Load(campaignSelector)
+	if err != nil {
+		return err
+	}
+
+	if c.HasMember(characterName) {
+		fmt.Fprintf(cmd.OutOrStdout(), "%s is already in %s's party.\n", characterName, c.Name)
+		return nil
+	}
+
+	if rec.Campaign != "" && rec.Campaign != c.Name {
+		if err := unlinkFromCampaign(rec.Campaign, characterName); err != nil {
+			return err
+		}
+	}
+
+	c.Party = append(c.Party, characterName)
+	if err := campaignStore.Save(c); err != nil {
+		return err
+	}
+
+	rec.Campaign = c.Name
+	if err := charStore.Save(rec); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Added %s to %s's party.\n", characterName, c.Name)
+	return nil
+}
+
+func runCampaignRemovePartyMember(cmd *cobra.Command, args []string) error {
+	characterName := args[0]
+
+	campaignStore, err := openCampaignStore()
+	if err != nil {
+		return err
+	}
+	c, err := campaignStore.Load(campaignSelector)
+	if err != nil {
+		return err
+	}
+
+	if !c.RemoveMember(characterName) {
+		return fmt.Errorf("%s is not in %s's party", characterName, c.Name)
+	}
+	if err := campaignStore.Save(c); err != nil {
+		return err
+	}
+
+	if charStore, err := openCharacterStore(); err == nil {
+		if rec, err := charStore.Load(characterName); err == nil && rec.Campaign == c.Name {
+			rec.Campaign = ""
+			charStore.Save(rec)
+		}
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Removed %s from %s's party.\n", characterName, c.Name)
+	return nil
+}
+
+func openCampaignStore() (*campaignstore.Store, error) {
+	fallback, err := characterstore.DefaultBaseDir()
+	if err != nil {
+		return nil, err
+	}
+	base, err := defaultDataDir(fallback)
+	if err != nil {
+		return nil, err
+	}
+	store, err := campaignstore.New(filepath.Join(base, "campaigns"))
+	if err != nil {
+		return nil, err
+	}
+	store.SetLogger(logger)
+	return store, nil
+}
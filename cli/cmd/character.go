@@ -1,20 +1,347 @@
 package cmd
 
 import (
+	"bufio"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
 
+	"github.com/osrapps/osrlib"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/osrapps/osr-console/cli/internal/characterstore"
 )
 
 var character = &cobra.Command{
 	Use:   "character {create|delete|list|show}",
 	Short: "Manage player characters.",
 	Long:  `Create, delete, list, and show player characters and their attributes.`,
-	Run: func(cmd *cobra.Command, args []string) {
-		fmt.Println("Character command executed")
-	},
 }
 
+var characterCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a new player character.",
+	Long: `Create a new player character, rolling ability scores and assigning class,
+race, and starting hit points via osrlib's rules engine.`,
+	RunE: runCharacterCreate,
+}
+
+var characterDeleteCmd = &cobra.Command{
+	Use:               "delete <name>",
+	Short:             "Delete a player character.",
+	Args:              cobra.ExactArgs(1),
+	RunE:              runCharacterDelete,
+	ValidArgsFunction: completeCharacterNames,
+}
+
+var characterListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List saved player characters.",
+	RunE:  runCharacterList,
+}
+
+var characterShowCmd = &cobra.Command{
+	Use:               "show <name>",
+	Short:             "Show a player character's full sheet.",
+	Args:              cobra.ExactArgs(1),
+	RunE:              runCharacterShow,
+	ValidArgsFunction: completeCharacterNames,
+}
+
+var (
+	createName   string
+	createClass  string
+	createRace   string
+	createMethod string
+	createForce  bool
+	deleteForce  bool
+
+	characterShowCampaign string
+)
+
 func init() {
 	rootCmd.AddCommand(character)
+	character.AddCommand(characterCreateCmd, characterDeleteCmd, characterListCmd, characterShowCmd)
+
+	characterCreateCmd.Flags().StringVar(&createName, "name", "", "character name (required)")
+	characterCreateCmd.Flags().StringVar(&createClass, "class", "fighter", "character class (fighter, cleric, magic-user, thief)")
+	characterCreateCmd.Flags().StringVar(&createRace, "race", "human", "character race (human, elf, dwarf, halfling)")
+	characterCreateCmd.Flags().StringVar(&createMethod, "method", "", "ability score roll method (3d6, 4d6-drop-lowest); defaults to the roll-method config value")
+	characterCreateCmd.Flags().BoolVar(&createForce, "force", false, "overwrite an existing character with the same name")
+	characterCreateCmd.MarkFlagRequired("name")
+
+	characterCreateCmd.RegisterFlagCompletionFunc("class", completeFixedValues("fighter", "cleric", "magic-user", "thief"))
+	characterCreateCmd.RegisterFlagCompletionFunc("race", completeFixedValues("human", "elf", "dwarf", "halfling"))
+	characterCreateCmd.RegisterFlagCompletionFunc("method", completeFixedValues("3d6", "4d6-drop-lowest"))
+
+	characterDeleteCmd.Flags().BoolVarP(&deleteForce, "yes", "y", false, "skip the confirmation prompt")
+}
+
+// completeCharacterNames completes a character name argument from the names
+// of saved characters.
+func completeCharacterNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	store, err := openCharacterStore()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	recs, err := store.List()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	names := make([]string, 0, len(recs))
+	for _, rec := range recs {
+		names = append(names, rec.Character.Name)
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
+func runCharacterCreate(cmd *cobra.Command, args []string) error {
+	class, err := parseClass(createClass)
+	if err != nil {
+		return err
+	}
+	race, err := parseRace(createRace)
+	if err != nil {
+		return err
+	}
+	methodFlag := createMethod
+	if methodFlag == "" {
+		methodFlag = viper.GetString("roll-method")
+	}
+	method, err := parseRollMethod(methodFlag)
+	if err != nil {
+		return err
+	}
+	ruleset, err := parseRuleset(viper.GetString("ruleset"))
+	if err != nil {
+		return err
+	}
+
+	store, err := openCharacterStore()
+	if err != nil {
+		return err
+	}
+	if !createForce {
+		exists, err := store.Exists(createName)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("character %q already exists (use --force to overwrite)", createName)
+		}
+	}
+
+	logger.Debug("rolling up character", "name", createName, "class", class, "race", race, "method", method, "ruleset", ruleset)
+	c, err := osrlib.NewCharacter(createName, class, race, method)
+	if err != nil {
+		return fmt.Errorf("rolling up %q: %w", createName, err)
+	}
+	logger.Info("character rolled", "name", c.Name, "level", c.Level, "hp", c.HitPoints.Max, "abilities", c.Abilities)
+
+	rec := &characterstore.Record{
+		ID:        c.Name,
+		CreatedAt: time.Now(),
+		Character: c,
+		Ruleset:   ruleset,
+	}
+	if err := store.Save(rec); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Created %s, a level %d %s %s.\n", c.Name, c.Level, c.Race, c.Class)
+	return nil
+}
+
+func runCharacterDelete(cmd *cobra.Command, args []string) error {
+	name := args[0]
+
+	store, err := openCharacterStore()
+	if err != nil {
+		return err
+	}
+	rec, err := store.Load(name)
+	if err != nil {
+		return err
+	}
+
+	if !deleteForce {
+		ok, err := confirm(cmd, fmt.Sprintf("Delete character %q? [y/N] ", name))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Fprintln(cmd.OutOrStdout(), "Aborted.")
+			return nil
+		}
+	}
+
+	if rec.Campaign != "" {
+		if err := unlinkFromCampaign(rec.Campaign, name); err != nil {
+			return err
+		}
+	}
+
+	if err := store.Delete(name); err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Deleted %s.\n", name)
+	return nil
+}
+
+// unlinkFromCampaign removes characterName from campaignName's party, so a
+// deleted character doesn't linger in a campaign's roster.
+func unlinkFromCampaign(campaignName, characterName string) error {
+	campaignStore, err := openCampaignStore()
+	if err != nil {
+		return err
+	}
+	c, err := campaignStore.Load(campaignName)
+	if err != nil {
+		return nil // campaign is already gone; nothing to unlink
+	}
+	if !c.RemoveMember(characterName) {
+		return nil
+	}
+	return campaignStore.Save(c)
+}
+
+func runCharacterList(cmd *cobra.Command, args []string) error {
+	store, err := openCharacterStore()
+	if err != nil {
+		return err
+	}
+
+	recs, err := store.List()
+	if err != nil {
+		return err
+	}
+	if len(recs) == 0 {
+		fmt.Fprintln(cmd.OutOrStdout(), "No characters found.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tLEVEL\tCLASS\tRACE\tHP\tRULESET")
+	for _, rec := range recs {
+		c := rec.Character
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%d/%d\t%s\n", c.Name, c.Level, c.Class, c.Race, c.HitPoints.Current, c.HitPoints.Max, rec.Ruleset)
+	}
+	return w.Flush()
+}
+
+func runCharacterShow(cmd *cobra.Command, args []string) error {
+	store, err := openCharacterStore()
+	if err != nil {
+		return err
+	}
+
+	rec, err := store.Load(args[0])
+	if err != nil {
+		return err
+	}
+	if characterShowCampaign != "" && rec.Campaign != characterShowCampaign {
+		return fmt.Errorf("%s is not a member of campaign %q", args[0], characterShowCampaign)
+	}
+	c := rec.Character
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "%s\n", c.Name)
+	fmt.Fprintf(w, "Level %d %s %s\n", c.Level, c.Race, c.Class)
+	fmt.Fprintf(w, "Ruleset:\t%s\n", rec.Ruleset)
+	fmt.Fprintf(w, "Hit Points:\t%d/%d\n", c.HitPoints.Current, c.HitPoints.Max)
+	fmt.Fprintf(w, "Armor Class:\t%d\n", c.ArmorClass)
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "ABILITY\tSCORE\tMODIFIER")
+	for _, a := range c.Abilities {
+		fmt.Fprintf(w, "%s\t%d\t%+d\n", a.Name, a.Score, a.Modifier)
+	}
+	return w.Flush()
+}
+
+func openCharacterStore() (*characterstore.Store, error) {
+	fallback, err := characterstore.DefaultBaseDir()
+	if err != nil {
+		return nil, err
+	}
+	base, err := defaultDataDir(fallback)
+	if err != nil {
+		return nil, err
+	}
+	store, err := characterstore.New(filepath.Join(base, "characters"))
+	if err != nil {
+		return nil, err
+	}
+	store.SetLogger(logger)
+	return store, nil
+}
+
+func parseClass(s string) (osrlib.ClassType, error) {
+	switch strings.ToLower(s) {
+	case "fighter":
+		return osrlib.ClassFighter, nil
+	case "cleric":
+		return osrlib.ClassCleric, nil
+	case "magic-user", "magicuser", "mu":
+		return osrlib.ClassMagicUser, nil
+	case "thief":
+		return osrlib.ClassThief, nil
+	default:
+		return 0, fmt.Errorf("unknown class %q (want fighter, cleric, magic-user, or thief)", s)
+	}
+}
+
+func parseRace(s string) (osrlib.RaceType, error) {
+	switch strings.ToLower(s) {
+	case "human":
+		return osrlib.RaceHuman, nil
+	case "elf":
+		return osrlib.RaceElf, nil
+	case "dwarf":
+		return osrlib.RaceDwarf, nil
+	case "halfling":
+		return osrlib.RaceHalfling, nil
+	default:
+		return 0, fmt.Errorf("unknown race %q (want human, elf, dwarf, or halfling)", s)
+	}
+}
+
+func parseRollMethod(s string) (osrlib.RollMethod, error) {
+	switch strings.ToLower(s) {
+	case "3d6", "down-the-line", "3d6-down-the-line":
+		return osrlib.RollMethod3d6DownTheLine, nil
+	case "4d6", "4d6-drop-lowest":
+		return osrlib.RollMethod4d6DropLowest, nil
+	default:
+		return 0, fmt.Errorf("unknown roll method %q (want 3d6 or 4d6-drop-lowest)", s)
+	}
+}
+
+// parseRuleset validates and normalizes the --ruleset flag / config value.
+// osrlib doesn't yet take a ruleset parameter, so the result is recorded on
+// the character for reference rather than passed into NewCharacter.
+func parseRuleset(s string) (string, error) {
+	switch strings.ToLower(s) {
+	case "bx", "b/x":
+		return "bx", nil
+	case "ose":
+		return "ose", nil
+	case "ad&d1e", "adnd1e", "ad&d", "1e":
+		return "ad&d1e", nil
+	default:
+		return "", fmt.Errorf("unknown ruleset %q (want bx, ose, or ad&d1e)", s)
+	}
+}
+
+func confirm(cmd *cobra.Command, prompt string) (bool, error) {
+	fmt.Fprint(cmd.OutOrStdout(), prompt)
+	scanner := bufio.NewScanner(os.Stdin)
+	if !scanner.Scan() {
+		return false, scanner.Err()
+	}
+	answer := strings.ToLower(strings.TrimSpace(scanner.Text()))
+	return answer == "y" || answer == "yes", nil
 }
@@ -0,0 +1,56 @@
+package cmd
+
+import "testing"
+
+func TestParseClass(t *testing.T) {
+	if _, err := parseClass("Fighter"); err != nil {
+		t.Errorf("parseClass(Fighter): %v", err)
+	}
+	if _, err := parseClass("bogus"); err == nil {
+		t.Error("parseClass(bogus) = nil error, want error")
+	}
+}
+
+func TestParseRace(t *testing.T) {
+	if _, err := parseRace("Halfling"); err != nil {
+		t.Errorf("parseRace(Halfling): %v", err)
+	}
+	if _, err := parseRace("bogus"); err == nil {
+		t.Error("parseRace(bogus) = nil error, want error")
+	}
+}
+
+func TestParseRollMethod(t *testing.T) {
+	cases := []string{"3d6", "down-the-line", "4d6-drop-lowest"}
+	for _, c := range cases {
+		if _, err := parseRollMethod(c); err != nil {
+			t.Errorf("parseRollMethod(%q): %v", c, err)
+		}
+	}
+	if _, err := parseRollMethod("bogus"); err == nil {
+		t.Error("parseRollMethod(bogus) = nil error, want error")
+	}
+}
+
+func TestParseRuleset(t *testing.T) {
+	cases := map[string]string{
+		"BX":     "bx",
+		"b/x":    "bx",
+		"OSE":    "ose",
+		"AD&D1e": "ad&d1e",
+		"1e":     "ad&d1e",
+	}
+	for in, want := range cases {
+		got, err := parseRuleset(in)
+		if err != nil {
+			t.Errorf("parseRuleset(%q): %v", in, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("parseRuleset(%q) = %q, want %q", in, got, want)
+		}
+	}
+	if _, err := parseRuleset("bogus"); err == nil {
+		t.Error("parseRuleset(bogus) = nil error, want error")
+	}
+}
@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var completionCmd = &cobra.Command{
+	Use:   "completion [bash|zsh|fish|powershell]",
+	Short: "Generate shell completion scripts.",
+	Long: `Generate a shell completion script for osr.
+
+Bash:
+
+  $ source <(osr completion bash)
+
+  # To load completions for every new session, execute once:
+  # Linux:
+  $ osr completion bash > /etc/bash_completion.d/osr
+  # macOS:
+  $ osr completion bash > $(brew --prefix)/etc/bash_completion.d/osr
+
+Zsh:
+
+  # If shell completion is not already enabled, enable it with:
+  $ echo "autoload -U compinit; compinit" >> ~/.zshrc
+
+  # To load completions for every new session, execute once:
+  $ osr completion zsh > "${fpath[1]}/_osr"
+
+  # You will need to start a new shell for this setup to take effect.
+
+Fish:
+
+  $ osr completion fish | source
+
+  # To load completions for every new session, execute once:
+  $ osr completion fish > ~/.config/fish/completions/osr.fish
+
+PowerShell:
+
+  PS> osr completion powershell | Out-String | Invoke-Expression
+
+  # To load completions for every new session, run:
+  PS> osr completion powershell > osr.ps1
+  # and source this file from your PowerShell profile.
+`,
+	DisableFlagsInUseLine: true,
+	ValidArgs:             []string{"bash", "zsh", "fish", "powershell"},
+	Args:                  cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		switch args[0] {
+		case "bash":
+			return cmd.Root().GenBashCompletionV2(os.Stdout, true)
+		case "zsh":
+			return cmd.Root().GenZshCompletion(os.Stdout)
+		case "fish":
+			return cmd.Root().GenFishCompletion(os.Stdout, true)
+		case "powershell":
+			return cmd.Root().GenPowerShellCompletionWithDesc(os.Stdout)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(completionCmd)
+}
+
+// completeFixedValues returns a flag completion function for a flag whose
+// valid values are a small, fixed set (e.g. --class, --race).
+func completeFixedValues(values ...string) func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return values, cobra.ShellCompDirectiveNoFileComp
+	}
+}
@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// logger is the CLI's shared structured logger. Subcommands log dice rolls,
+// save/load operations, and rules-engine decisions to it instead of relying
+// on a debugger, giving GMs a replayable audit trail of a session.
+var logger = slog.Default()
+
+// initLogging configures the shared logger from the --log-level,
+// --log-format, and --log-file flags (or their OSR_ env / config file
+// equivalents) before any subcommand runs.
+func initLogging(cmd *cobra.Command, args []string) error {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(viper.GetString("log-level"))); err != nil {
+		return fmt.Errorf("invalid --log-level: %w", err)
+	}
+
+	var out io.Writer = os.Stderr
+	if path := viper.GetString("log-file"); path != "" {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return fmt.Errorf("opening log file %q: %w", path, err)
+		}
+		out = f
+	}
+
+	opts := &slog.HandlerOptions{Level: level}
+	var handler slog.Handler
+	switch viper.GetString("log-format") {
+	case "json":
+		handler = slog.NewJSONHandler(out, opts)
+	case "text":
+		handler = slog.NewTextHandler(out, opts)
+	default:
+		return fmt.Errorf("invalid --log-format %q (want text or json)", viper.GetString("log-format"))
+	}
+
+	logger = slog.New(handler)
+	slog.SetDefault(logger)
+	return nil
+}
@@ -1,25 +1,82 @@
 package cmd
 
 import (
+	"fmt"
+	"os"
+	"strings"
+
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
+var cfgFile string
+
 var rootCmd = &cobra.Command{
-	Use:   "osr",
-	Short: "The command-line interface to osrlib.",
-	Long:  `The 'osr' CLI provides command-line access to osrlib and its rules engine.`,
+	Use:               "osr",
+	Short:             "The command-line interface to osrlib.",
+	Long:              `The 'osr' CLI provides command-line access to osrlib and its rules engine.`,
+	PersistentPreRunE: initLogging,
 }
 
 func Execute() error {
-	return rootCmd.Execute()
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return err
+	}
+	return nil
 }
 
 func init() {
-	// TODO: Define flags and configuration settings.
-	//
-	// Persistent flag example:
-	// rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.myapp.yaml)")
-	//
-	// Local flag example:
-	// rootCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+	cobra.OnInitialize(initConfig)
+
+	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.osr.yaml)")
+
+	rootCmd.PersistentFlags().String("data-dir", "", "directory where characters and campaigns are stored")
+	rootCmd.PersistentFlags().String("ruleset", "bx", "default ruleset (bx, ose, ad&d1e)")
+	rootCmd.PersistentFlags().String("roll-method", "3d6", "default ability score roll method")
+	rootCmd.PersistentFlags().String("log-level", "info", "log level (debug, info, warn, error)")
+	rootCmd.PersistentFlags().String("log-format", "text", "log format (text, json)")
+	rootCmd.PersistentFlags().String("log-file", "", "file to write logs to (default is stderr)")
+	viper.BindPFlag("data-dir", rootCmd.PersistentFlags().Lookup("data-dir"))
+	viper.BindPFlag("ruleset", rootCmd.PersistentFlags().Lookup("ruleset"))
+	viper.BindPFlag("roll-method", rootCmd.PersistentFlags().Lookup("roll-method"))
+	viper.BindPFlag("log-level", rootCmd.PersistentFlags().Lookup("log-level"))
+	viper.BindPFlag("log-format", rootCmd.PersistentFlags().Lookup("log-format"))
+	viper.BindPFlag("log-file", rootCmd.PersistentFlags().Lookup("log-file"))
+}
+
+// initConfig reads in the config file and ENV variables, if set. It runs via
+// cobra.OnInitialize so every subcommand sees the merged configuration
+// before its RunE executes.
+func initConfig() {
+	if cfgFile != "" {
+		viper.SetConfigFile(cfgFile)
+	} else {
+		home, err := os.UserHomeDir()
+		cobra.CheckErr(err)
+
+		viper.AddConfigPath(home)
+		viper.SetConfigType("yaml")
+		viper.SetConfigName(".osr")
+	}
+
+	viper.SetEnvPrefix("OSR")
+	viper.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
+	viper.AutomaticEnv()
+
+	if err := viper.ReadInConfig(); err == nil {
+		fmt.Fprintln(os.Stderr, "Using config file:", viper.ConfigFileUsed())
+	} else if cfgFile != "" {
+		cobra.CheckErr(fmt.Errorf("reading config file %s: %w", cfgFile, err))
+	}
+}
+
+// defaultDataDir returns the configured data directory, falling back to the
+// OS-appropriate default when neither a flag, env var, nor config file value
+// is set.
+func defaultDataDir(fallback string) (string, error) {
+	if dir := viper.GetString("data-dir"); dir != "" {
+		return dir, nil
+	}
+	return fallback, nil
 }
@@ -0,0 +1,95 @@
+// Package campaignstore persists campaigns to disk as JSON. A campaign links
+// a party of characters, their current location, accumulated XP, and session
+// notes into the organizational layer above individual characters.
+package campaignstore
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/osrapps/osr-console/cli/internal/jsonstore"
+)
+
+// Campaign is the on-disk representation of a campaign.
+type Campaign struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	Party        []string  `json:"party"` // character names
+	Location     string    `json:"location"`
+	XP           int       `json:"xp"`
+	SessionNotes []string  `json:"session_notes,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// HasMember reports whether name is already in the party.
+func (c *Campaign) HasMember(name string) bool {
+	for _, member := range c.Party {
+		if member == name {
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveMember removes name from the party, reporting whether it was
+// present.
+func (c *Campaign) RemoveMember(name string) bool {
+	party := c.Party[:0]
+	removed := false
+	for _, member := range c.Party {
+		if member == name {
+			removed = true
+			continue
+		}
+		party = append(party, member)
+	}
+	c.Party = party
+	return removed
+}
+
+// Store reads and writes campaigns, one JSON file per campaign.
+type Store struct {
+	inner *jsonstore.Store[Campaign]
+}
+
+// New returns a Store rooted at dir, creating dir if it doesn't already
+// exist.
+func New(dir string) (*Store, error) {
+	inner, err := jsonstore.New[Campaign](dir, "campaign")
+	if err != nil {
+		return nil, err
+	}
+	return &Store{inner: inner}, nil
+}
+
+// SetLogger routes the store's save/load/delete log entries through logger
+// instead of slog.Default().
+func (s *Store) SetLogger(logger *slog.Logger) {
+	s.inner.Logger = logger
+}
+
+// Save writes c to disk, overwriting any existing campaign with the same
+// name.
+func (s *Store) Save(c *Campaign) error {
+	return s.inner.Save(c.Name, c)
+}
+
+// Load reads the campaign with the given name.
+func (s *Store) Load(name string) (*Campaign, error) {
+	return s.inner.Load(name)
+}
+
+// Exists reports whether a campaign with the given name is already saved.
+func (s *Store) Exists(name string) (bool, error) {
+	return s.inner.Exists(name)
+}
+
+// List returns every saved campaign, sorted by name.
+func (s *Store) List() ([]*Campaign, error) {
+	return s.inner.List(func(a, b *Campaign) bool { return a.Name < b.Name })
+}
+
+// Delete removes the campaign with the given name.
+func (s *Store) Delete(name string) error {
+	return s.inner.Delete(name)
+}
@@ -0,0 +1,31 @@
+package campaignstore
+
+import "testing"
+
+func TestCampaignHasMember(t *testing.T) {
+	c := &Campaign{Party: []string{"Conan", "Bilbo"}}
+	if !c.HasMember("Conan") {
+		t.Error("HasMember(Conan) = false, want true")
+	}
+	if c.HasMember("Gandalf") {
+		t.Error("HasMember(Gandalf) = true, want false")
+	}
+}
+
+func TestCampaignRemoveMember(t *testing.T) {
+	c := &Campaign{Party: []string{"Conan", "Bilbo", "Drizzt"}}
+
+	if !c.RemoveMember("Bilbo") {
+		t.Fatal("RemoveMember(Bilbo) = false, want true")
+	}
+	if c.HasMember("Bilbo") {
+		t.Error("Bilbo still in party after RemoveMember")
+	}
+	if len(c.Party) != 2 {
+		t.Errorf("len(Party) = %d, want 2", len(c.Party))
+	}
+
+	if c.RemoveMember("Gandalf") {
+		t.Error("RemoveMember(Gandalf) = true, want false (not a member)")
+	}
+}
@@ -0,0 +1,103 @@
+// Package characterstore persists player characters to disk as JSON so the
+// CLI can create a character in one invocation and list, show, or delete it
+// in another.
+package characterstore
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/osrapps/osrlib"
+
+	"github.com/osrapps/osr-console/cli/internal/jsonstore"
+)
+
+// Record is the on-disk representation of a saved character. It wraps the
+// osrlib character sheet with the bookkeeping fields the store itself needs.
+type Record struct {
+	ID        string            `json:"id"`
+	CreatedAt time.Time         `json:"created_at"`
+	Character *osrlib.Character `json:"character"`
+	Campaign  string            `json:"campaign,omitempty"`
+	Ruleset   string            `json:"ruleset,omitempty"`
+}
+
+// Store reads and writes character records, one JSON file per character.
+type Store struct {
+	inner *jsonstore.Store[Record]
+}
+
+// New returns a Store rooted at dir, creating dir if it doesn't already
+// exist.
+func New(dir string) (*Store, error) {
+	inner, err := jsonstore.New[Record](dir, "character")
+	if err != nil {
+		return nil, err
+	}
+	return &Store{inner: inner}, nil
+}
+
+// DefaultBaseDir returns the OS-appropriate root data directory for
+// osr-console, e.g. $XDG_CONFIG_HOME/osr-console on Linux. Callers append
+// their own subdirectory (characters, campaigns, ...).
+func DefaultBaseDir() (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("characterstore: resolving config dir: %w", err)
+	}
+	return filepath.Join(configDir, "osr-console"), nil
+}
+
+// SetLogger routes the store's save/load/delete log entries through logger
+// instead of slog.Default().
+func (s *Store) SetLogger(logger *slog.Logger) {
+	s.inner.Logger = logger
+}
+
+// Save writes rec to disk, overwriting any existing record with the same
+// name.
+func (s *Store) Save(rec *Record) error {
+	return s.inner.Save(rec.Character.Name, rec)
+}
+
+// Load reads the character record with the given name.
+func (s *Store) Load(name string) (*Record, error) {
+	return s.inner.Load(name)
+}
+
+// Exists reports whether a character with the given name is already saved.
+func (s *Store) Exists(name string) (bool, error) {
+	return s.inner.Exists(name)
+}
+
+// List returns every saved character, sorted by name. Records with a nil
+// Character (e.g. a hand-edited or truncated save) are skipped rather than
+// panicking the sort.
+func (s *Store) List() ([]*Record, error) {
+	recs, err := s.inner.List(func(a, b *Record) bool {
+		if a.Character == nil || b.Character == nil {
+			return a.Character != nil
+		}
+		return a.Character.Name < b.Character.Name
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := recs[:0]
+	for _, rec := range recs {
+		if rec.Character == nil {
+			continue
+		}
+		out = append(out, rec)
+	}
+	return out, nil
+}
+
+// Delete removes the character record with the given name.
+func (s *Store) Delete(name string) error {
+	return s.inner.Delete(name)
+}
@@ -0,0 +1,135 @@
+// Package jsonstore implements a generic one-file-per-record JSON store,
+// shared by characterstore and campaignstore so the on-disk layout and
+// naming rules live in exactly one place.
+package jsonstore
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Store reads and writes values of type T as one JSON file per record under
+// Dir. Kind names the record type in error and log messages (e.g.
+// "character", "campaign"). Logger, if set, receives an entry for every
+// save, load, and delete; a nil Logger falls back to slog.Default().
+type Store[T any] struct {
+	Dir    string
+	Kind   string
+	Logger *slog.Logger
+}
+
+var slugPattern = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// New returns a Store rooted at dir, creating dir if it doesn't already
+// exist.
+func New[T any](dir, kind string) (*Store[T], error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("%sstore: creating data dir: %w", kind, err)
+	}
+	return &Store[T]{Dir: dir, Kind: kind}, nil
+}
+
+// Save writes v to disk under name, overwriting any existing record with
+// that name.
+func (s *Store[T]) Save(name string, v *T) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("%sstore: encoding %q: %w", s.Kind, name, err)
+	}
+	if err := os.WriteFile(s.path(name), data, 0o644); err != nil {
+		return fmt.Errorf("%sstore: writing %q: %w", s.Kind, name, err)
+	}
+	s.log().Debug(fmt.Sprintf("saved %s", s.Kind), "name", name)
+	return nil
+}
+
+// Load reads the record with the given name.
+func (s *Store[T]) Load(name string) (*T, error) {
+	data, err := os.ReadFile(s.path(name))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%sstore: no %s named %q", s.Kind, s.Kind, name)
+		}
+		return nil, fmt.Errorf("%sstore: reading %q: %w", s.Kind, name, err)
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, fmt.Errorf("%sstore: decoding %q: %w", s.Kind, name, err)
+	}
+	s.log().Debug(fmt.Sprintf("loaded %s", s.Kind), "name", name)
+	return &v, nil
+}
+
+// Exists reports whether a record with the given name is already on disk.
+func (s *Store[T]) Exists(name string) (bool, error) {
+	_, err := os.Stat(s.path(name))
+	if err == nil {
+		return true, nil
+	}
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	return false, fmt.Errorf("%sstore: checking %q: %w", s.Kind, name, err)
+}
+
+// List returns every saved record, in the order returned by less.
+func (s *Store[T]) List(less func(a, b *T) bool) ([]*T, error) {
+	entries, err := os.ReadDir(s.Dir)
+	if err != nil {
+		return nil, fmt.Errorf("%sstore: reading data dir: %w", s.Kind, err)
+	}
+
+	var all []*T
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(s.Dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("%sstore: reading %q: %w", s.Kind, entry.Name(), err)
+		}
+		var v T
+		if err := json.Unmarshal(data, &v); err != nil {
+			return nil, fmt.Errorf("%sstore: decoding %q: %w", s.Kind, entry.Name(), err)
+		}
+		all = append(all, &v)
+	}
+
+	sort.Slice(all, func(i, j int) bool { return less(all[i], all[j]) })
+	return all, nil
+}
+
+// Delete removes the record with the given name.
+func (s *Store[T]) Delete(name string) error {
+	if err := os.Remove(s.path(name)); err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("%sstore: no %s named %q", s.Kind, s.Kind, name)
+		}
+		return fmt.Errorf("%sstore: deleting %q: %w", s.Kind, name, err)
+	}
+	s.log().Debug(fmt.Sprintf("deleted %s", s.Kind), "name", name)
+	return nil
+}
+
+func (s *Store[T]) log() *slog.Logger {
+	if s.Logger != nil {
+		return s.Logger
+	}
+	return slog.Default()
+}
+
+func (s *Store[T]) path(name string) string {
+	return filepath.Join(s.Dir, Slugify(name)+".json")
+}
+
+// Slugify turns name into a filesystem-safe, lowercase, hyphenated key.
+func Slugify(name string) string {
+	slug := slugPattern.ReplaceAllString(strings.ToLower(strings.TrimSpace(name)), "-")
+	return strings.Trim(slug, "-")
+}
@@ -0,0 +1,66 @@
+package jsonstore
+
+import (
+	"testing"
+)
+
+type widget struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+func TestSlugify(t *testing.T) {
+	cases := map[string]string{
+		"Conan":           "conan",
+		"  Bilbo  ":       "bilbo",
+		"Mad Mardigan":    "mad-mardigan",
+		"Drizzt Do'Urden": "drizzt-do-urden",
+	}
+	for in, want := range cases {
+		if got := Slugify(in); got != want {
+			t.Errorf("Slugify(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestSlugifyCollision(t *testing.T) {
+	a, b := "Mad Mardigan", "Mad  Mardigan!"
+	if Slugify(a) != Slugify(b) {
+		t.Errorf("expected %q and %q to slugify to the same key, got %q and %q", a, b, Slugify(a), Slugify(b))
+	}
+}
+
+func TestSaveLoadDelete(t *testing.T) {
+	store, err := New[widget](t.TempDir(), "widget")
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	want := &widget{Name: "Gizmo", Count: 3}
+	if err := store.Save(want.Name, want); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	got, err := store.Load(want.Name)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if got.Name != want.Name || got.Count != want.Count {
+		t.Errorf("Load = %+v, want %+v", got, want)
+	}
+
+	all, err := store.List(func(a, b *widget) bool { return a.Name < b.Name })
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(all) != 1 {
+		t.Fatalf("List returned %d records, want 1", len(all))
+	}
+
+	if err := store.Delete(want.Name); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := store.Load(want.Name); err == nil {
+		t.Error("Load after Delete succeeded, want error")
+	}
+}